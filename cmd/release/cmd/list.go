@@ -8,6 +8,7 @@ import (
 	"github.com/rancher/ecm-distro-tools/release/charts"
 	"github.com/rancher/ecm-distro-tools/release/rancher"
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
 )
 
 // listCmd represents the list command
@@ -44,6 +45,33 @@ var rancherListRCDepsSubCmd = &cobra.Command{
 	},
 }
 
+var k3sListSubCmd = &cobra.Command{
+	Use:   "k3s",
+	Short: "List K3s Utilities",
+}
+
+var k3sListVersionsSubCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "List the K3s versions present in the config file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if rootConfig.K3s == nil {
+			return errors.New("verify your config file, no k3s section found")
+		}
+
+		versions := make([]string, 0, len(rootConfig.K3s.Versions))
+		for version := range rootConfig.K3s.Versions {
+			versions = append(versions, version)
+		}
+		semver.Sort(versions)
+
+		for _, version := range versions {
+			fmt.Println(version)
+		}
+
+		return nil
+	},
+}
+
 var chartsListSubCmd = &cobra.Command{
 	Use:   "charts [branch] [charts](optional)",
 	Short: "List Charts assets versions state for release process",
@@ -77,6 +105,8 @@ var chartsListSubCmd = &cobra.Command{
 func init() {
 	rancherListSubCmd.AddCommand(rancherListRCDepsSubCmd)
 	listCmd.AddCommand(rancherListSubCmd)
+	k3sListSubCmd.AddCommand(k3sListVersionsSubCmd)
+	listCmd.AddCommand(k3sListSubCmd)
 	listCmd.AddCommand(chartsListSubCmd)
 	rootCmd.AddCommand(listCmd)
 }
@@ -29,7 +29,7 @@ var pushK3sTagsCmd = &cobra.Command{
 		version := args[0]
 		k3sRelease, found := rootConfig.K3s.Versions[version]
 		if !found {
-			return errors.New("verify your config file, version not found: " + version)
+			return errors.New("verify your config file, version not found: " + version + ", use 'release list k3s versions' to see the available versions")
 		}
 		ctx := context.Background()
 		ghClient := repository.NewGithub(ctx, rootConfig.Auth.GithubToken)
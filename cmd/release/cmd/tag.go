@@ -44,7 +44,7 @@ var k3sTagSubCmd = &cobra.Command{
 		tag := args[1]
 		k3sRelease, found := rootConfig.K3s.Versions[tag]
 		if !found {
-			return errors.New("verify your config file, version not found: " + tag)
+			return errors.New("verify your config file, version not found: " + tag + ", use 'release list k3s versions' to see the available versions")
 		}
 		ctx := context.Background()
 		ghClient := repository.NewGithub(ctx, rootConfig.Auth.GithubToken)
@@ -187,7 +187,7 @@ var systemAgentInstallerK3sTagSubCmd = &cobra.Command{
 
 		k3sRelease, found := rootConfig.K3s.Versions[tag]
 		if !found {
-			return errors.New("verify your config file, version not found: " + tag)
+			return errors.New("verify your config file, version not found: " + tag + ", use 'release list k3s versions' to see the available versions")
 		}
 
 		ctx := context.Background()
@@ -31,7 +31,7 @@ var updateK3sReferencesCmd = &cobra.Command{
 
 		k3sRelease, found := rootConfig.K3s.Versions[version]
 		if !found {
-			return errors.New("verify your config file, version not found: " + version)
+			return errors.New("verify your config file, version not found: " + version + ", use 'release list k3s versions' to see the available versions")
 		}
 
 		ctx := context.Background()